@@ -0,0 +1,89 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/BurntSushi/toml"
+)
+
+// SMTPConfig holds the connection and delivery settings for the send pipeline.
+// Values are resolved by LoadConfig in the order: config file, then env var
+// overrides, so `MASS_MAILER_*` env vars always win over the TOML file.
+type SMTPConfig struct {
+	Host            string `toml:"host"`
+	Port            int    `toml:"port"`
+	Username        string `toml:"username"`
+	Password        string `toml:"password"`
+	From            string `toml:"from"`
+	Concurrency     int    `toml:"concurrency"`
+	RateLimitPerSec int    `toml:"rate_limit_per_sec"`
+	MaxRetries      int    `toml:"max_retries"`
+}
+
+func defaultSMTPConfig() SMTPConfig {
+	return SMTPConfig{
+		Port:            587,
+		Concurrency:     4,
+		RateLimitPerSec: 5,
+		MaxRetries:      3,
+	}
+}
+
+// configPath returns ~/.config/mass-mailer/config.toml, the on-disk location
+// users can drop SMTP credentials into instead of exporting env vars.
+func configPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "mass-mailer", "config.toml"), nil
+}
+
+// LoadConfig reads the TOML config file if present, then applies any
+// MASS_MAILER_* environment variable overrides on top of it.
+func LoadConfig() (SMTPConfig, error) {
+	cfg := defaultSMTPConfig()
+
+	if path, err := configPath(); err == nil {
+		if _, statErr := os.Stat(path); statErr == nil {
+			if _, err := toml.DecodeFile(path, &cfg); err != nil {
+				return cfg, err
+			}
+		}
+	}
+
+	applyEnvOverrides(&cfg)
+	return cfg, nil
+}
+
+func applyEnvOverrides(cfg *SMTPConfig) {
+	if v := os.Getenv("MASS_MAILER_SMTP_HOST"); v != "" {
+		cfg.Host = v
+	}
+	if v := os.Getenv("MASS_MAILER_SMTP_PORT"); v != "" {
+		if port, err := strconv.Atoi(v); err == nil {
+			cfg.Port = port
+		}
+	}
+	if v := os.Getenv("MASS_MAILER_SMTP_USERNAME"); v != "" {
+		cfg.Username = v
+	}
+	if v := os.Getenv("MASS_MAILER_SMTP_PASSWORD"); v != "" {
+		cfg.Password = v
+	}
+	if v := os.Getenv("MASS_MAILER_SMTP_FROM"); v != "" {
+		cfg.From = v
+	}
+	if v := os.Getenv("MASS_MAILER_CONCURRENCY"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Concurrency = n
+		}
+	}
+	if v := os.Getenv("MASS_MAILER_RATE_LIMIT_PER_SEC"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.RateLimitPerSec = n
+		}
+	}
+}
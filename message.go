@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// buildMessage composes a full RFC 5322 message: a multipart/alternative
+// text+HTML body, optionally wrapped in multipart/mixed with one
+// base64-encoded part per attachment.
+func buildMessage(from, to, subject, htmlBody string, attachments []string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	mixedBoundary := randomMultipartBoundary()
+	mixedWriter := multipart.NewWriter(&buf)
+	if err := mixedWriter.SetBoundary(mixedBoundary); err != nil {
+		return nil, err
+	}
+
+	writeHeaders(&buf, []headerField{
+		{"From", from},
+		{"To", to},
+		{"Subject", mime.QEncoding.Encode("utf-8", subject)},
+		{"MIME-Version", "1.0"},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"Content-Type", fmt.Sprintf("multipart/mixed; boundary=%q", mixedBoundary)},
+	})
+	buf.WriteString("\r\n")
+
+	altBoundary := randomMultipartBoundary()
+	altPart, err := mixedWriter.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {fmt.Sprintf("multipart/alternative; boundary=%q", altBoundary)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	altWriter := multipart.NewWriter(altPart)
+	if err := altWriter.SetBoundary(altBoundary); err != nil {
+		return nil, err
+	}
+	if err := writeAlternativeBody(altWriter, htmlBody); err != nil {
+		return nil, err
+	}
+	if err := altWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	for _, path := range attachments {
+		if err := attachFile(mixedWriter, path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mixedWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type headerField struct {
+	name  string
+	value string
+}
+
+func writeHeaders(buf *bytes.Buffer, headers []headerField) {
+	for _, h := range headers {
+		fmt.Fprintf(buf, "%s: %s\r\n", h.name, h.value)
+	}
+}
+
+// writeAlternativeBody writes a text/plain fallback (the HTML body with its
+// tags stripped) followed by the real text/html part.
+func writeAlternativeBody(w *multipart.Writer, htmlBody string) error {
+	textPart, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/plain; charset=\"utf-8\""},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+	if err := writeQuotedPrintable(textPart, stripHTMLTags(htmlBody)); err != nil {
+		return err
+	}
+
+	htmlPart, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/html; charset=\"utf-8\""},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+	return writeQuotedPrintable(htmlPart, htmlBody)
+}
+
+func writeQuotedPrintable(w io.Writer, body string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// attachFile reads path and appends it to w as a base64-encoded part, with
+// its Content-Type sniffed from the file contents.
+func attachFile(w *multipart.Writer, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read attachment %s: %w", path, err)
+	}
+
+	part, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {http.DetectContentType(data)},
+		"Content-Transfer-Encoding": {"base64"},
+		"Content-Disposition":       {fmt.Sprintf("attachment; filename=%q", filepath.Base(path))},
+	})
+	if err != nil {
+		return err
+	}
+
+	encoder := base64.NewEncoder(base64.StdEncoding, part)
+	if _, err := encoder.Write(data); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// stripHTMLTags produces a crude plain-text fallback for the
+// multipart/alternative text part.
+func stripHTMLTags(html string) string {
+	return strings.TrimSpace(htmlTagPattern.ReplaceAllString(html, ""))
+}
+
+func randomMultipartBoundary() string {
+	return multipart.NewWriter(io.Discard).Boundary()
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// Recipient is one row of the uploaded CSV, keyed by the original
+// (whitespace-trimmed) header name so templates can reference merge fields
+// exactly as they appear in the CSV, e.g. {{.Name}}, {{.Company}}.
+type Recipient map[string]string
+
+// Email returns the recipient's address, auto-detected from whichever CSV
+// column is named "email", matched case-insensitively against whatever
+// casing that column's header actually used.
+func (r Recipient) Email() string {
+	for k, v := range r {
+		if strings.EqualFold(k, "email") {
+			return v
+		}
+	}
+	return ""
+}
+
+// loadRecipients parses the CSV at path and auto-detects the email column by
+// header name. All columns are kept as merge fields, including email.
+func loadRecipients(path string) ([]Recipient, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open csv: %w", err)
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+
+	cols := make([]string, len(header))
+	emailCol := -1
+	for i, h := range header {
+		col := strings.TrimSpace(h)
+		cols[i] = col
+		if strings.EqualFold(col, "email") {
+			emailCol = i
+		}
+	}
+	if emailCol == -1 {
+		return nil, fmt.Errorf("no \"email\" column found in csv header %v", header)
+	}
+
+	var recipients []Recipient
+	for {
+		row, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv row %d: %w", len(recipients)+2, err)
+		}
+		rec := make(Recipient, len(cols))
+		for i, col := range cols {
+			if i < len(row) {
+				rec[col] = row[i]
+			}
+		}
+		if rec.Email() == "" {
+			continue
+		}
+		normalized, err := normalizeEmailAddress(rec.Email())
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", len(recipients)+2, err)
+		}
+		rec[cols[emailCol]] = normalized
+		recipients = append(recipients, rec)
+	}
+
+	return recipients, nil
+}
+
+// normalizeEmailAddress rejects anything that isn't a single well-formed
+// RFC 5322 address (so a CSV field can't smuggle CR/LF sequences into the
+// SMTP envelope or message headers built from it) and returns its bare
+// address, stripping any display-name wrapper like "Ada <ada@example.com>"
+// that would otherwise land malformed inside an SMTP RCPT/MAIL command.
+func normalizeEmailAddress(email string) (string, error) {
+	if strings.ContainsAny(email, "\r\n") {
+		return "", fmt.Errorf("email address %q contains control characters", email)
+	}
+	addr, err := mail.ParseAddress(email)
+	if err != nil {
+		return "", fmt.Errorf("invalid email address %q: %w", email, err)
+	}
+	return addr.Address, nil
+}
+
+// renderTemplate executes a text/template source against a recipient's merge
+// fields and returns the resulting string.
+func renderTemplate(name, source string, rec Recipient) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(source)
+	if err != nil {
+		return "", fmt.Errorf("parse template %s: %w", name, err)
+	}
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, rec); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// sendProgressMsg reports the running totals for an in-flight campaign. The
+// progress view re-renders on each one instead of animating a fake timer.
+type sendProgressMsg struct {
+	Sent     int
+	Failed   int
+	Retrying int
+	Total    int
+}
+
+// sendResultMsg reports the terminal outcome of a single recipient's send.
+type sendResultMsg struct {
+	Recipient string
+	Attempt   int
+	Err       error
+}
+
+// sendDoneMsg is emitted once every recipient has reached a terminal state.
+type sendDoneMsg struct {
+	LogPath string
+}
+
+// deliveryLogEntry is one JSONL record written to the delivery log.
+type deliveryLogEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Recipient string    `json:"recipient"`
+	Attempt   int       `json:"attempt"`
+	Success   bool      `json:"success"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// campaign drives the worker pool that sends one rendered email per
+// recipient and streams progress back to the TUI over events.
+type campaign struct {
+	cfg        SMTPConfig
+	recipients []Recipient
+	subjectSrc string
+	bodySrc    string
+	attachPath []string
+	events     chan tea.Msg
+}
+
+func newCampaign(cfg SMTPConfig, recipients []Recipient, subjectSrc, bodySrc string, attachments []string) *campaign {
+	return &campaign{
+		cfg:        cfg,
+		recipients: recipients,
+		subjectSrc: subjectSrc,
+		bodySrc:    bodySrc,
+		attachPath: attachments,
+		events:     make(chan tea.Msg, 64),
+	}
+}
+
+// waitForCampaignEvent returns a tea.Cmd that blocks on the campaign's event
+// channel and forwards whatever arrives as the next bubbletea message.
+func waitForCampaignEvent(c *campaign) tea.Cmd {
+	return func() tea.Msg {
+		return <-c.events
+	}
+}
+
+// run launches the worker pool and blocks until every recipient has been
+// attempted. It is meant to be invoked via go c.run(), with progress
+// observed through c.events.
+func (c *campaign) run() {
+	logPath, logFile, err := openDeliveryLog()
+	if err != nil {
+		c.events <- sendResultMsg{Err: fmt.Errorf("open delivery log: %w", err)}
+		close(c.events)
+		return
+	}
+	defer logFile.Close()
+
+	concurrency := c.cfg.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	limiter := newTokenBucket(c.cfg.RateLimitPerSec)
+
+	jobs := make(chan Recipient)
+	results := make(chan sendResultMsg)
+	done := make(chan struct{})
+
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			for rec := range jobs {
+				limiter.wait()
+				results <- c.sendWithRetry(rec)
+			}
+		}()
+	}
+
+	go func() {
+		for _, rec := range c.recipients {
+			jobs <- rec
+		}
+		close(jobs)
+	}()
+
+	go func() {
+		sent, failed := 0, 0
+		total := len(c.recipients)
+		for i := 0; i < total; i++ {
+			res := <-results
+			if res.Err != nil {
+				failed++
+			} else {
+				sent++
+			}
+			logDelivery(logFile, res)
+			c.events <- res
+			c.events <- sendProgressMsg{Sent: sent, Failed: failed, Total: total}
+		}
+		close(done)
+	}()
+
+	<-done
+	c.events <- sendDoneMsg{LogPath: logPath}
+	close(c.events)
+}
+
+// sendWithRetry attempts delivery to a single recipient, retrying with
+// exponential backoff when the SMTP server responds with a 4xx (transient)
+// status code. Permanent 5xx failures are not retried.
+func (c *campaign) sendWithRetry(rec Recipient) sendResultMsg {
+	maxRetries := c.cfg.MaxRetries
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= maxRetries+1; attempt++ {
+		err := c.deliver(rec)
+		if err == nil {
+			return sendResultMsg{Recipient: rec.Email(), Attempt: attempt}
+		}
+		lastErr = err
+		if !isTransientSMTPError(err) || attempt == maxRetries+1 {
+			break
+		}
+		backoff := time.Duration(math.Pow(2, float64(attempt-1))) * 500 * time.Millisecond
+		time.Sleep(backoff)
+	}
+	return sendResultMsg{Recipient: rec.Email(), Attempt: maxRetries + 1, Err: lastErr}
+}
+
+// deliver renders the subject and body for rec and sends one message over
+// STARTTLS-upgraded SMTP.
+func (c *campaign) deliver(rec Recipient) error {
+	subject, err := renderTemplate("subject", c.subjectSrc, rec)
+	if err != nil {
+		return err
+	}
+	body, err := renderTemplate("body", c.bodySrc, rec)
+	if err != nil {
+		return err
+	}
+
+	msg, err := buildMessage(c.cfg.From, rec.Email(), subject, body, c.attachPath)
+	if err != nil {
+		return err
+	}
+
+	return sendViaSTARTTLS(c.cfg, rec.Email(), msg)
+}
+
+// sendViaSTARTTLS dials the configured SMTP host, upgrades the connection
+// with STARTTLS, authenticates, and hands off the already-composed message.
+func sendViaSTARTTLS(cfg SMTPConfig, to string, msg []byte) error {
+	addr := net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port))
+
+	client, err := smtp.Dial(addr)
+	if err != nil {
+		return fmt.Errorf("dial %s: %w", addr, err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		tlsConfig := &tls.Config{ServerName: cfg.Host}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("starttls: %w", err)
+		}
+	}
+
+	if cfg.Username != "" {
+		auth := smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("auth: %w", err)
+		}
+	}
+
+	if err := client.Mail(cfg.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// isTransientSMTPError reports whether err wraps an SMTP status in the 4xx
+// range, which per RFC 5321 indicates the client should retry later.
+func isTransientSMTPError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) {
+		return protoErr.Code >= 400 && protoErr.Code < 500
+	}
+	return false
+}
+
+func openDeliveryLog() (string, *os.File, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", nil, err
+	}
+	dir := filepath.Join(home, ".cache", "mass-mailer", "logs")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", nil, err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("delivery-%d.jsonl", time.Now().Unix()))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return "", nil, err
+	}
+	return path, f, nil
+}
+
+func logDelivery(f *os.File, res sendResultMsg) {
+	entry := deliveryLogEntry{
+		Timestamp: time.Now(),
+		Recipient: res.Recipient,
+		Attempt:   res.Attempt,
+		Success:   res.Err == nil,
+	}
+	if res.Err != nil {
+		entry.Error = res.Err.Error()
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	f.Write(append(line, '\n'))
+}
+
+// tokenBucket is a simple token-bucket rate limiter: it refills one token
+// per tick at the configured rate and blocks callers until one is available.
+type tokenBucket struct {
+	ticker *time.Ticker
+}
+
+func newTokenBucket(ratePerSec int) *tokenBucket {
+	if ratePerSec < 1 {
+		ratePerSec = 1
+	}
+	interval := time.Second / time.Duration(ratePerSec)
+	return &tokenBucket{ticker: time.NewTicker(interval)}
+}
+
+func (t *tokenBucket) wait() {
+	<-t.ticker.C
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ViewState identifies one screen of the wizard. It replaces the old
+// currentView int constants as the key into model.views.
+type ViewState int
+
+const (
+	HomeState ViewState = iota
+	FileSelectionState
+	MarkdownCSVSelectionState
+	MarkdownComposeState
+	SubjectInputState
+	AttachmentsState
+	ConfirmationState
+	PreviewState
+	ProgressState
+	QuitState
+)
+
+// View is one screen of the wizard. Screens own their own sub-model state
+// and keymap/help text; the only way to move between them is to return a
+// transitionMsg from Update.
+type View interface {
+	Init() tea.Cmd
+	Update(msg tea.Msg) (View, tea.Cmd)
+	View() string
+	Help() []key.Binding
+
+	// CapturesKey reports whether the view wants msg delivered to its own
+	// Update instead of being intercepted by model's global "q"/ctrl+c quit
+	// shortcut — true for a view with a focused text widget that needs to
+	// receive those as literal keystrokes (or that manages its own quit
+	// sequence, like progressScreen's send debounce).
+	CapturesKey(msg tea.KeyMsg) bool
+}
+
+// transitionMsg asks model to switch the active screen to To, handing it
+// Payload as the first message its Update sees so it can pick up whatever
+// the previous screen collected.
+type transitionMsg struct {
+	To      ViewState
+	Payload any
+}
+
+// fileSelectionPayload carries the chosen CSV/HTML paths from
+// fileSelectionScreen into subjectInputScreen.
+type fileSelectionPayload struct {
+	CSVPath  string
+	HTMLPath string
+}
+
+// markdownCSVPayload carries just the chosen CSV path from the
+// markdown-compose CSV picker into markdownComposeScreen.
+type markdownCSVPayload struct {
+	CSVPath string
+}
+
+// markdownComposePayload carries the CSV path and the rendered HTML body
+// from markdownComposeScreen into subjectInputScreen, in place of an HTML
+// file path.
+type markdownComposePayload struct {
+	CSVPath  string
+	HTMLBody string
+}
+
+// subjectPayload carries the wizard's state from subjectInputScreen into
+// attachmentsScreen. Exactly one of HTMLPath/HTMLBody is set, depending on
+// whether the body came from an uploaded file or the markdown composer.
+type subjectPayload struct {
+	CSVPath  string
+	HTMLPath string
+	HTMLBody string
+	Subject  string
+}
+
+// attachmentsPayload carries the wizard's state from attachmentsScreen into
+// confirmationScreen.
+type attachmentsPayload struct {
+	CSVPath     string
+	HTMLPath    string
+	HTMLBody    string
+	Subject     string
+	Attachments []string
+}
+
+// confirmationPayload carries the wizard's state from confirmationScreen
+// into previewScreen, and unchanged from previewScreen into progressScreen,
+// where it triggers the actual send.
+type confirmationPayload struct {
+	CSVPath     string
+	HTMLPath    string
+	HTMLBody    string
+	Subject     string
+	Attachments []string
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	padding          = 2
+	maxWidth         = 100
+	debounceDuration = 5 * time.Second
+)
+
+var (
+	titleStyle         = lipgloss.NewStyle().MarginLeft(2).Foreground(lipgloss.Color("205"))
+	itemStyle          = lipgloss.NewStyle().PaddingLeft(4).Align(lipgloss.Center, lipgloss.Center).Foreground(lipgloss.Color("200"))
+	helpStyle          = lipgloss.NewStyle().Foreground(lipgloss.Color("#626262")).Render
+	selectedItemStyle  = lipgloss.NewStyle().PaddingLeft(2).Foreground(lipgloss.Color("170"))
+	statusMessageStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.AdaptiveColor{Light: "#04B575", Dark: "#04B575"}).
+				Render
+	asciiStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("63"))
+	quitTextStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render
+	style         = baseFrameStyle(0, 0)
+)
+
+// baseFrameStyle builds the outer border/padding frame, sizing it to the
+// current terminal instead of the fixed Width(300) that used to overflow
+// small terminals. windowWidth/windowHeight of 0 (no resize seen yet) fall
+// back to a reasonable default so the very first paint isn't unbounded.
+func baseFrameStyle(windowWidth, windowHeight int) lipgloss.Style {
+	width := windowWidth - 4
+	switch {
+	case windowWidth == 0:
+		width = maxWidth
+	case width > maxWidth:
+		width = maxWidth
+	case width < 20:
+		width = 20
+	}
+
+	hPad, vPad, margin := 10, 2, 2
+	switch {
+	case windowWidth == 0:
+		hPad, vPad, margin = 10, 2, 10
+	case windowWidth < 80:
+		hPad, vPad = 2, 1
+	case windowHeight < 24:
+		vPad = 1
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		PaddingLeft(hPad).
+		PaddingRight(hPad).
+		PaddingTop(vPad).
+		MarginRight(margin).
+		MarginTop(margin).
+		Align(lipgloss.Left).
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("63")).
+		BorderTop(true).
+		BorderLeft(true).
+		BorderRight(true).
+		BorderBottom(true)
+}
+
+// quitKey is the binding shared by every screen's Help() so bubbles/help
+// always shows how to exit, no matter which view is active.
+var quitKey = key.NewBinding(
+	key.WithKeys("q", "ctrl+c"),
+	key.WithHelp("q", "quit"),
+)
+
+type delegateKeyMap struct {
+	choose key.Binding
+	remove key.Binding
+}
+
+func (d delegateKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		d.choose,
+		d.remove,
+	}
+}
+
+func (d delegateKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{
+			d.choose,
+			d.remove,
+		},
+	}
+}
+
+func newDelegateKeyMap() *delegateKeyMap {
+	return &delegateKeyMap{
+		choose: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "choose"),
+		),
+		remove: key.NewBinding(
+			key.WithKeys("x", "backspace"),
+			key.WithHelp("x", "delete"),
+		),
+	}
+}
+
+func newItemDelegate(keys *delegateKeyMap) list.DefaultDelegate {
+	d := list.NewDefaultDelegate()
+	d.UpdateFunc = func(msg tea.Msg, m *list.Model) tea.Cmd {
+		var title string
+		if i, ok := m.SelectedItem().(homeItem); ok {
+			title = i.Title()
+		} else {
+			return nil
+		}
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			switch {
+			case key.Matches(msg, keys.choose):
+				return m.NewStatusMessage(statusMessageStyle("You chose " + title))
+			case key.Matches(msg, keys.remove):
+				index := m.Index()
+				m.RemoveItem(index)
+				if len(m.Items()) == 0 {
+					keys.remove.SetEnabled(false)
+				}
+				return m.NewStatusMessage(statusMessageStyle("Deleted " + title))
+			}
+		}
+		return nil
+	}
+	help := []key.Binding{keys.choose, keys.remove}
+	d.ShortHelpFunc = func() []key.Binding {
+		return help
+	}
+	d.FullHelpFunc = func() [][]key.Binding {
+		return [][]key.Binding{help}
+	}
+	return d
+}
+
+// viewHelpAdapter satisfies help.KeyMap so a View's own Help() bindings can
+// be rendered through the shared bubbles/help model.
+type viewHelpAdapter struct {
+	keys []key.Binding
+}
+
+func (a viewHelpAdapter) ShortHelp() []key.Binding {
+	return a.keys
+}
+
+func (a viewHelpAdapter) FullHelp() [][]key.Binding {
+	return [][]key.Binding{a.keys}
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/filepicker"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// attachmentItem is one file the user has staged for attachment.
+type attachmentItem struct {
+	path string
+}
+
+func (i attachmentItem) Title() string       { return filepath.Base(i.path) }
+func (i attachmentItem) Description() string { return i.path }
+func (i attachmentItem) FilterValue() string { return i.path }
+
+// attachmentDelegateKeyMap is the attachments list's own keymap, following
+// the same choose/remove shape as delegateKeyMap but with an extra binding
+// to open the filepicker.
+type attachmentDelegateKeyMap struct {
+	attach key.Binding
+	remove key.Binding
+	next   key.Binding
+}
+
+func newAttachmentDelegateKeyMap() *attachmentDelegateKeyMap {
+	return &attachmentDelegateKeyMap{
+		attach: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "attach file"),
+		),
+		remove: key.NewBinding(
+			key.WithKeys("x", "backspace"),
+			key.WithHelp("x", "remove"),
+		),
+		next: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "continue"),
+		),
+	}
+}
+
+func newAttachmentDelegate(keys *attachmentDelegateKeyMap) list.DefaultDelegate {
+	d := list.NewDefaultDelegate()
+	d.UpdateFunc = func(msg tea.Msg, m *list.Model) tea.Cmd {
+		item, ok := m.SelectedItem().(attachmentItem)
+		if !ok {
+			return nil
+		}
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			if key.Matches(msg, keys.remove) {
+				m.RemoveItem(m.Index())
+				return m.NewStatusMessage(statusMessageStyle("Removed " + filepath.Base(item.path)))
+			}
+		}
+		return nil
+	}
+	help := []key.Binding{keys.attach, keys.remove, keys.next}
+	d.ShortHelpFunc = func() []key.Binding { return help }
+	d.FullHelpFunc = func() [][]key.Binding { return [][]key.Binding{help} }
+	return d
+}
+
+// attachmentsScreen lets the user pick zero or more files to attach before
+// moving on to confirmationScreen.
+type attachmentsScreen struct {
+	list       list.Model
+	filepicker filepicker.Model
+	picking    bool
+	keys       *attachmentDelegateKeyMap
+
+	csvFilePath  string
+	htmlFilePath string
+	htmlBody     string
+	subject      string
+}
+
+func newAttachmentsScreen() *attachmentsScreen {
+	home, _ := os.UserHomeDir()
+
+	fp := filepicker.New()
+	fp.CurrentDirectory = home
+
+	keys := newAttachmentDelegateKeyMap()
+	l := list.New(nil, newAttachmentDelegate(keys), 0, 0)
+	l.Title = "Attachments"
+	l.SetShowTitle(false)
+	l.SetFilteringEnabled(false)
+
+	return &attachmentsScreen{list: l, filepicker: fp, keys: keys}
+}
+
+func (s *attachmentsScreen) Init() tea.Cmd { return s.filepicker.Init() }
+
+func (s *attachmentsScreen) Update(msg tea.Msg) (View, tea.Cmd) {
+	if t, ok := msg.(transitionMsg); ok {
+		if payload, ok := t.Payload.(subjectPayload); ok {
+			s.csvFilePath = payload.CSVPath
+			s.htmlFilePath = payload.HTMLPath
+			s.htmlBody = payload.HTMLBody
+			s.subject = payload.Subject
+		}
+		return s, nil
+	}
+
+	if s.picking {
+		var cmd tea.Cmd
+		s.filepicker, cmd = s.filepicker.Update(msg)
+
+		if selected, path := s.filepicker.DidSelectFile(msg); selected {
+			s.picking = false
+			cmd = tea.Batch(cmd, s.list.InsertItem(len(s.list.Items()), attachmentItem{path: path}))
+			return s, cmd
+		}
+
+		if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "esc" {
+			s.picking = false
+			return s, nil
+		}
+		return s, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		h, v := style.GetFrameSize()
+		s.list.SetSize(msg.Width-h, msg.Height-v)
+		s.filepicker.Height = msg.Height - v
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, s.keys.attach):
+			s.picking = true
+			return s, s.filepicker.Init()
+		case key.Matches(msg, s.keys.next):
+			csvPath, htmlPath, htmlBody, subject := s.csvFilePath, s.htmlFilePath, s.htmlBody, s.subject
+			attachments := make([]string, 0, len(s.list.Items()))
+			for _, it := range s.list.Items() {
+				if a, ok := it.(attachmentItem); ok {
+					attachments = append(attachments, a.path)
+				}
+			}
+			return s, func() tea.Msg {
+				return transitionMsg{
+					To: ConfirmationState,
+					Payload: attachmentsPayload{
+						CSVPath: csvPath, HTMLPath: htmlPath, HTMLBody: htmlBody,
+						Subject: subject, Attachments: attachments,
+					},
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	s.list, cmd = s.list.Update(msg)
+	return s, cmd
+}
+
+func (s *attachmentsScreen) View() string {
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("Attachments"))
+	sb.WriteString("\n\n")
+
+	if s.picking {
+		sb.WriteString(itemStyle.Render(fmt.Sprintf("Current Directory: %s", s.filepicker.CurrentDirectory)))
+		sb.WriteString("\n")
+		sb.WriteString(s.filepicker.View())
+		return sb.String()
+	}
+
+	if len(s.list.Items()) == 0 {
+		sb.WriteString(itemStyle.Render("No attachments yet."))
+	} else {
+		for i, it := range s.list.Items() {
+			a, ok := it.(attachmentItem)
+			if !ok {
+				continue
+			}
+			line := fmt.Sprintf("%s (%s)", a.Title(), a.Description())
+			if i == s.list.Index() {
+				sb.WriteString(selectedItemStyle.Render("> " + line))
+			} else {
+				sb.WriteString(itemStyle.Render("  " + line))
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String()
+}
+
+func (s *attachmentsScreen) Help() []key.Binding {
+	if s.picking {
+		return []key.Binding{
+			key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+		}
+	}
+	return []key.Binding{s.keys.attach, s.keys.remove, s.keys.next, quitKey}
+}
+
+// CapturesKey reports true for everything but ctrl+c while the filepicker
+// is open, since "q" should browse directories rather than quit the wizard.
+// Outside of picking, the list has no free-text entry.
+func (s *attachmentsScreen) CapturesKey(msg tea.KeyMsg) bool {
+	return s.picking && msg.String() != "ctrl+c"
+}
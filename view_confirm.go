@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// confirmationScreen shows a summary of the wizard's choices and asks for a
+// yes/no before handing off to previewScreen, which renders a few sample
+// emails ahead of the actual send.
+type confirmationScreen struct {
+	csvFilePath  string
+	htmlFilePath string
+	htmlBody     string
+	subject      string
+	attachments  []string
+	cursor       int
+}
+
+func (s *confirmationScreen) Init() tea.Cmd { return nil }
+
+func (s *confirmationScreen) Update(msg tea.Msg) (View, tea.Cmd) {
+	if t, ok := msg.(transitionMsg); ok {
+		if payload, ok := t.Payload.(attachmentsPayload); ok {
+			s.csvFilePath = payload.CSVPath
+			s.htmlFilePath = payload.HTMLPath
+			s.htmlBody = payload.HTMLBody
+			s.subject = payload.Subject
+			s.attachments = payload.Attachments
+			s.cursor = 0
+		}
+		return s, nil
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "down", "j":
+			s.cursor++
+			if s.cursor > 1 {
+				s.cursor = 0
+			}
+		case "up", "k":
+			s.cursor--
+			if s.cursor < 0 {
+				s.cursor = 1
+			}
+		case "enter":
+			if s.cursor == 0 {
+				csvPath, htmlPath, htmlBody := s.csvFilePath, s.htmlFilePath, s.htmlBody
+				subject, attachments := s.subject, s.attachments
+				return s, func() tea.Msg {
+					return transitionMsg{
+						To: PreviewState,
+						Payload: confirmationPayload{
+							CSVPath: csvPath, HTMLPath: htmlPath, HTMLBody: htmlBody,
+							Subject: subject, Attachments: attachments,
+						},
+					}
+				}
+			}
+			return s, tea.Quit
+		}
+	}
+
+	return s, nil
+}
+
+func (s *confirmationScreen) View() string {
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("Confirmation"))
+	sb.WriteString("\n\n")
+	htmlSource := s.htmlFilePath
+	if s.htmlBody != "" {
+		htmlSource = "(composed in Markdown)"
+	}
+
+	sb.WriteString(itemStyle.Render(fmt.Sprintf("CSV file: %s", s.csvFilePath)))
+	sb.WriteString("\n")
+	sb.WriteString(itemStyle.Render(fmt.Sprintf("HTML body: %s", htmlSource)))
+	sb.WriteString("\n")
+	sb.WriteString(itemStyle.Render(fmt.Sprintf("Subject: %s", s.subject)))
+	sb.WriteString("\n")
+	sb.WriteString(itemStyle.Render(fmt.Sprintf("Attachments: %d", len(s.attachments))))
+	sb.WriteString("\n\n")
+	sb.WriteString(itemStyle.Render("Send emails?"))
+	sb.WriteString("\n")
+	for i, choice := range []string{"Yes", "No"} {
+		if s.cursor == i {
+			sb.WriteString(itemStyle.Render(fmt.Sprintf("(•) %s", choice)))
+		} else {
+			sb.WriteString(itemStyle.Render(fmt.Sprintf("( ) %s", choice)))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func (s *confirmationScreen) Help() []key.Binding {
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("up", "down"), key.WithHelp("↑/↓", "choose")),
+		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "confirm")),
+		quitKey,
+	}
+}
+
+// CapturesKey reports false: the Yes/No choice has no free-text entry, so
+// "q" and ctrl+c should always fall through to the global quit shortcut.
+func (s *confirmationScreen) CapturesKey(tea.KeyMsg) bool { return false }
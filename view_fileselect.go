@@ -0,0 +1,167 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/filepicker"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// fileSelectionScreen walks the user through picking a CSV recipient list
+// and then an HTML template, one filepicker.Model at a time. In csvOnly
+// mode (the markdown-compose entry point) it transitions away as soon as
+// the CSV is picked, skipping the HTML step entirely.
+type fileSelectionScreen struct {
+	csvFilepicker  filepicker.Model
+	htmlFilepicker filepicker.Model
+	csvFilePath    string
+	htmlFilePath   string
+	csvOnly        bool
+	lastMsg        tea.Msg
+	windowSize     tea.WindowSizeMsg
+}
+
+func newFileSelectionScreen() *fileSelectionScreen {
+	return newFileSelectionScreenMode(false)
+}
+
+func newCSVOnlyFileSelectionScreen() *fileSelectionScreen {
+	return newFileSelectionScreenMode(true)
+}
+
+func newFileSelectionScreenMode(csvOnly bool) *fileSelectionScreen {
+	home, _ := os.UserHomeDir()
+
+	csvFp := filepicker.New()
+	csvFp.AllowedTypes = []string{".csv"}
+	csvFp.CurrentDirectory = home
+
+	htmlFp := filepicker.New()
+	htmlFp.AllowedTypes = []string{".html"}
+	htmlFp.CurrentDirectory = home
+
+	return &fileSelectionScreen{csvFilepicker: csvFp, htmlFilepicker: htmlFp, csvOnly: csvOnly}
+}
+
+func (s *fileSelectionScreen) Init() tea.Cmd {
+	return tea.Batch(s.csvFilepicker.Init(), s.htmlFilepicker.Init())
+}
+
+func (s *fileSelectionScreen) Update(msg tea.Msg) (View, tea.Cmd) {
+	if _, ok := msg.(transitionMsg); ok {
+		// Re-list both directories on entry: the readDirMsg from Init's
+		// startup broadcast resolved while some other screen was active and
+		// was dropped, so the filepicker needs a fresh readDir now that this
+		// screen is actually visible.
+		s.csvFilePath, s.htmlFilePath, s.lastMsg = "", "", nil
+		return s, s.Init()
+	}
+
+	s.lastMsg = msg
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		s.windowSize = msg
+		_, v := style.GetFrameSize()
+		height := msg.Height - v - 6
+		if height < 1 {
+			height = 1
+		}
+		s.csvFilepicker.Height = height
+		s.htmlFilepicker.Height = height
+		return s, nil
+
+	case tea.KeyMsg:
+		if msg.String() == "enter" {
+			if s.csvFilePath == "" {
+				s.csvFilepicker, cmd = s.csvFilepicker.Update(msg)
+				if selected, path := s.csvFilepicker.DidSelectFile(msg); selected {
+					s.csvFilePath = path
+					if s.csvOnly {
+						return s, func() tea.Msg {
+							return transitionMsg{To: MarkdownComposeState, Payload: markdownCSVPayload{CSVPath: path}}
+						}
+					}
+					s.htmlFilepicker.CurrentDirectory = s.csvFilepicker.CurrentDirectory
+				}
+				return s, cmd
+			}
+
+			s.htmlFilepicker, cmd = s.htmlFilepicker.Update(msg)
+			if selected, path := s.htmlFilepicker.DidSelectFile(msg); selected {
+				s.htmlFilePath = path
+				csvPath, htmlPath := s.csvFilePath, s.htmlFilePath
+				return s, func() tea.Msg {
+					return transitionMsg{
+						To:      SubjectInputState,
+						Payload: fileSelectionPayload{CSVPath: csvPath, HTMLPath: htmlPath},
+					}
+				}
+			}
+			return s, cmd
+		}
+	}
+
+	if s.csvFilePath == "" {
+		s.csvFilepicker, cmd = s.csvFilepicker.Update(msg)
+	} else {
+		s.htmlFilepicker, cmd = s.htmlFilepicker.Update(msg)
+	}
+	return s, cmd
+}
+
+func (s *fileSelectionScreen) View() string {
+	title := "File Selection"
+	if s.csvOnly {
+		title = "Select Recipient CSV"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(title))
+	sb.WriteString("\n\n")
+
+	if s.csvFilePath == "" {
+		sb.WriteString(itemStyle.Render(fmt.Sprintf("Current Directory: %s", s.csvFilepicker.CurrentDirectory)))
+		sb.WriteString("\n")
+		sb.WriteString(itemStyle.Render("Select CSV file:"))
+		sb.WriteString("\n")
+		fpView := s.csvFilepicker.View()
+		sb.WriteString(fpView)
+		if len(fpView) == 0 {
+			sb.WriteString("No files found in this directory.\n")
+		} else if selected, path := s.csvFilepicker.DidSelectFile(s.lastMsg); selected {
+			sb.WriteString(itemStyle.Render(fmt.Sprintf("Selected CSV File: %s", path)))
+		}
+	} else {
+		sb.WriteString(itemStyle.Render(fmt.Sprintf("CSV file: %s", s.csvFilePath)))
+		sb.WriteString("\n")
+		sb.WriteString(itemStyle.Render(fmt.Sprintf("Current Directory: %s", s.htmlFilepicker.CurrentDirectory)))
+		sb.WriteString("\n")
+		sb.WriteString(itemStyle.Render("Select HTML file:"))
+		sb.WriteString("\n")
+		fpView := s.htmlFilepicker.View()
+		sb.WriteString(fpView)
+		if len(fpView) == 0 {
+			sb.WriteString("No files found in this directory.\n")
+		} else if selected, path := s.htmlFilepicker.DidSelectFile(s.lastMsg); selected {
+			sb.WriteString(itemStyle.Render(fmt.Sprintf("Selected HTML File: %s", path)))
+		}
+	}
+
+	return sb.String()
+}
+
+func (s *fileSelectionScreen) Help() []key.Binding {
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+		quitKey,
+	}
+}
+
+// CapturesKey reports false: the filepicker has no free-text entry, so "q"
+// and ctrl+c should always fall through to the global quit shortcut.
+func (s *fileSelectionScreen) CapturesKey(tea.KeyMsg) bool { return false }
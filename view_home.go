@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// homeAsciiHeight is the fixed number of rows the banner occupies; the menu
+// below it gets whatever vertical space is left over from the terminal.
+const homeAsciiHeight = 10
+
+type homeItem struct {
+	title       string
+	description string
+}
+
+func (i homeItem) Title() string       { return i.title }
+func (i homeItem) Description() string { return i.description }
+func (i homeItem) FilterValue() string { return i.title }
+
+// homeScreen is the wizard's main menu.
+type homeScreen struct {
+	list       list.Model
+	keys       *delegateKeyMap
+	windowSize tea.WindowSizeMsg
+}
+
+func newHomeScreen() *homeScreen {
+	delegateKeys := newDelegateKeyMap()
+
+	items := []list.Item{
+		homeItem{title: "CSV and HTML Upload", description: "Pick CSV and HTML files for email"},
+		homeItem{title: "Compose in Markdown", description: "Pick a CSV and write the email body as Markdown"},
+		homeItem{title: "Quit", description: "Exit the application"},
+	}
+
+	delegate := newItemDelegate(delegateKeys)
+	l := list.New(items, delegate, 0, 0)
+	l.Title = "Main Menu"
+	l.SetShowTitle(false)
+	l.SetFilteringEnabled(false)
+	l.Styles.Title = titleStyle
+
+	return &homeScreen{list: l, keys: delegateKeys}
+}
+
+func (s *homeScreen) Init() tea.Cmd { return nil }
+
+func (s *homeScreen) Update(msg tea.Msg) (View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		s.windowSize = msg
+		h, v := style.GetFrameSize()
+		s.list.SetSize(msg.Width-h, msg.Height-v)
+		return s, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			s.list.CursorUp()
+			return s, nil
+		case "down", "j":
+			s.list.CursorDown()
+			return s, nil
+		case "enter":
+			if selected, ok := s.list.SelectedItem().(homeItem); ok {
+				switch selected.title {
+				case "CSV and HTML Upload":
+					return s, func() tea.Msg {
+						return transitionMsg{To: FileSelectionState}
+					}
+				case "Compose in Markdown":
+					return s, func() tea.Msg {
+						return transitionMsg{To: MarkdownCSVSelectionState}
+					}
+				case "Quit":
+					return s, tea.Quit
+				}
+			}
+			return s, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	s.list, cmd = s.list.Update(msg)
+	return s, cmd
+}
+
+func (s *homeScreen) View() string {
+	asciiArt := `
+ /$$$$$$$$                                  /$$                                      /$$$$$$  /$$           /$$
+| $$_____/                                 | $$                                     /$$__  $$| $$          | $$
+| $$     /$$$$$$  /$$   /$$ /$$$$$$$   /$$$$$$$  /$$$$$$   /$$$$$$   /$$$$$$$      | $$  \__/| $$ /$$   /$$| $$$$$$$
+| $$$$$ /$$__  $$| $$  | $$| $$__  $$ /$$__  $$ /$$__  $$ /$$__  $$ /$$_____/      | $$      | $$| $$  | $$| $$__  $$
+| $$__/| $$  \ $$| $$  | $$| $$  \ $$| $$  | $$| $$$$$$$$| $$  \__/|  $$$$$$       | $$      | $$| $$  | $$| $$  \ $$
+| $$   | $$  | $$| $$  | $$| $$  | $$| $$  | $$| $$_____/| $$       \____  $$      | $$    $$| $$| $$  | $$| $$  | $$
+| $$   |  $$$$$$/|  $$$$$$/| $$  | $$|  $$$$$$$|  $$$$$$$| $$       /$$$$$$$/      |  $$$$$$/| $$|  $$$$$$/| $$$$$$$/
+|__/    \______/  \______/ |__/  |__/ \_______/ \_______/|__/      |_______/        \______/ |__/ \______/ |_______/
+
+`
+	centeredAscii := lipgloss.Place(s.windowSize.Width, homeAsciiHeight,
+		lipgloss.Center, lipgloss.Center,
+		asciiStyle.Render(asciiArt))
+
+	items := []string{}
+	for i, listItem := range s.list.Items() {
+		it, ok := listItem.(homeItem)
+		if !ok {
+			continue
+		}
+		if i == s.list.Index() {
+			items = append(items, selectedItemStyle.Render(fmt.Sprintf("> %s", it.title)))
+		} else {
+			items = append(items, itemStyle.Render(fmt.Sprintf("  %s", it.title)))
+		}
+	}
+
+	menu := lipgloss.JoinVertical(lipgloss.Center, items...)
+	menuHeight := s.windowSize.Height - homeAsciiHeight
+	if menuHeight < 1 {
+		menuHeight = 1
+	}
+	centeredMenu := lipgloss.Place(s.windowSize.Width, menuHeight,
+		lipgloss.Center, lipgloss.Center,
+		menu)
+
+	return lipgloss.JoinVertical(lipgloss.Center, centeredAscii, centeredMenu)
+}
+
+func (s *homeScreen) Help() []key.Binding {
+	return []key.Binding{s.keys.choose, quitKey}
+}
+
+// CapturesKey reports false: the menu has no focused text widget, so "q"
+// and ctrl+c should always fall through to the global quit shortcut.
+func (s *homeScreen) CapturesKey(tea.KeyMsg) bool { return false }
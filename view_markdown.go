@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// markdownComposeScreen is an alternative to uploading an HTML file: the
+// user writes the email body as Markdown and sees a live HTML preview
+// rendered alongside the editor.
+type markdownComposeScreen struct {
+	textarea    textarea.Model
+	preview     string
+	previewOnly bool
+	csvFilePath string
+	md          goldmark.Markdown
+}
+
+func newMarkdownComposeScreen() *markdownComposeScreen {
+	ta := textarea.New()
+	ta.Placeholder = "Write your email in Markdown..."
+	ta.Focus()
+
+	md := goldmark.New(goldmark.WithExtensions(extension.GFM))
+
+	return &markdownComposeScreen{textarea: ta, md: md}
+}
+
+func (s *markdownComposeScreen) Init() tea.Cmd { return textarea.Blink }
+
+func (s *markdownComposeScreen) Update(msg tea.Msg) (View, tea.Cmd) {
+	if t, ok := msg.(transitionMsg); ok {
+		if payload, ok := t.Payload.(markdownCSVPayload); ok {
+			s.csvFilePath = payload.CSVPath
+		}
+		return s, textarea.Blink
+	}
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		h, v := style.GetFrameSize()
+		width := msg.Width - h
+		if width > 1 {
+			s.textarea.SetWidth(width / 2)
+		}
+		if height := msg.Height - v - 6; height > 0 {
+			s.textarea.SetHeight(height)
+		}
+		return s, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+p":
+			s.previewOnly = !s.previewOnly
+			return s, nil
+		case "ctrl+c":
+			s.saveDraft()
+			return s, tea.Quit
+		case "ctrl+d":
+			csvPath, body := s.csvFilePath, s.renderHTML()
+			return s, func() tea.Msg {
+				return transitionMsg{
+					To:      SubjectInputState,
+					Payload: markdownComposePayload{CSVPath: csvPath, HTMLBody: body},
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	s.textarea, cmd = s.textarea.Update(msg)
+	s.preview = s.renderHTML()
+	return s, cmd
+}
+
+// renderHTML converts the current draft to HTML with GFM tables,
+// strikethrough, and autolinks enabled.
+func (s *markdownComposeScreen) renderHTML() string {
+	var buf bytes.Buffer
+	if err := s.md.Convert([]byte(s.textarea.Value()), &buf); err != nil {
+		return fmt.Sprintf("render error: %v", err)
+	}
+	return buf.String()
+}
+
+// saveDraft writes the current Markdown source to
+// ~/.cache/mass-mailer/drafts/<timestamp>.md so a quit mid-compose doesn't
+// lose the user's work.
+func (s *markdownComposeScreen) saveDraft() {
+	if s.textarea.Value() == "" {
+		return
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return
+	}
+	dir := filepath.Join(home, ".cache", "mass-mailer", "drafts")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%d.md", time.Now().Unix()))
+	_ = os.WriteFile(path, []byte(s.textarea.Value()), 0o644)
+}
+
+func (s *markdownComposeScreen) View() string {
+	if s.previewOnly {
+		return lipgloss.JoinVertical(lipgloss.Left,
+			titleStyle.Render("Markdown Preview"),
+			itemStyle.Render(s.preview),
+		)
+	}
+
+	editor := lipgloss.JoinVertical(lipgloss.Left, itemStyle.Render("Markdown"), s.textarea.View())
+	preview := lipgloss.JoinVertical(lipgloss.Left, itemStyle.Render("Preview"), itemStyle.Render(s.preview))
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		titleStyle.Render("Compose in Markdown"),
+		lipgloss.JoinHorizontal(lipgloss.Top, editor, preview),
+	)
+}
+
+func (s *markdownComposeScreen) Help() []key.Binding {
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("ctrl+p"), key.WithHelp("ctrl+p", "toggle preview")),
+		key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("ctrl+d", "continue")),
+		key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "save draft & quit")),
+	}
+}
+
+// CapturesKey reports true: the textarea is always focused here, and the
+// screen manages its own ctrl+c (save draft & quit) instead of the global
+// shortcut, so every key must reach Update.
+func (s *markdownComposeScreen) CapturesKey(tea.KeyMsg) bool { return true }
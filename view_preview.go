@@ -0,0 +1,238 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+)
+
+// previewLimit caps how many recipients get templated for previewScreen, so
+// opening it on a large CSV doesn't block rendering every row up front.
+const previewLimit = 3
+
+// previewedEmail is one recipient's fully rendered subject/body, or the
+// template error hit while rendering it.
+type previewedEmail struct {
+	email   string
+	subject string
+	body    string
+	err     error
+}
+
+// previewScreen renders the first previewLimit recipients' templated emails
+// so the user can catch missing merge fields or malformed CSV rows before
+// anything is actually sent. Confirming here is what triggers the send
+// pipeline; it hands the unmodified confirmationPayload on to progressScreen.
+type previewScreen struct {
+	payload confirmationPayload
+
+	viewport viewport.Model
+	total    int
+	previews []previewedEmail
+	err      error
+	index    int
+	rendered bool
+}
+
+func newPreviewScreen() *previewScreen {
+	return &previewScreen{viewport: viewport.New(0, 0)}
+}
+
+func (s *previewScreen) Init() tea.Cmd { return nil }
+
+func (s *previewScreen) Update(msg tea.Msg) (View, tea.Cmd) {
+	if t, ok := msg.(transitionMsg); ok {
+		if payload, ok := t.Payload.(confirmationPayload); ok {
+			s.payload = payload
+			s.index = 0
+			s.total, s.previews, s.err = buildPreviews(payload)
+			s.viewport.SetContent(s.currentBody())
+			s.viewport.GotoTop()
+		}
+		return s, nil
+	}
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		h, v := style.GetFrameSize()
+		if width := msg.Width - h; width > 0 {
+			s.viewport.Width = width
+		}
+		if height := msg.Height - v - 8; height > 0 {
+			s.viewport.Height = height
+		}
+		s.viewport.SetContent(s.currentBody())
+		return s, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "tab":
+			s.switchRecipient(1)
+			return s, nil
+		case "shift+tab":
+			s.switchRecipient(-1)
+			return s, nil
+		case "ctrl+r":
+			s.rendered = !s.rendered
+			s.viewport.SetContent(s.currentBody())
+			return s, nil
+		case "enter":
+			payload := s.payload
+			return s, func() tea.Msg {
+				return transitionMsg{To: ProgressState, Payload: payload}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	s.viewport, cmd = s.viewport.Update(msg)
+	return s, cmd
+}
+
+// switchRecipient moves the active preview tab by delta, wrapping around,
+// and loads the new tab's body into the viewport.
+func (s *previewScreen) switchRecipient(delta int) {
+	if len(s.previews) == 0 {
+		return
+	}
+	s.index = (s.index + delta + len(s.previews)) % len(s.previews)
+	s.viewport.SetContent(s.currentBody())
+	s.viewport.GotoTop()
+}
+
+// currentBody returns the active tab's body as raw HTML, or run through
+// glamour for a terminal-rendered version when toggled.
+func (s *previewScreen) currentBody() string {
+	if s.index >= len(s.previews) {
+		return ""
+	}
+	p := s.previews[s.index]
+	if p.err != nil {
+		return ""
+	}
+	if !s.rendered {
+		return p.body
+	}
+	out, err := glamour.Render(p.body, "dark")
+	if err != nil {
+		return p.body
+	}
+	return out
+}
+
+// buildPreviews loads the campaign's recipients and renders the subject and
+// body template against the first previewLimit of them, returning the total
+// recipient count alongside so the view can show "N of total". A template
+// failure on one recipient is kept on that recipient's tab rather than
+// aborting the whole preview, since a missing merge field in row 2 shouldn't
+// hide a clean preview of row 1.
+func buildPreviews(p confirmationPayload) (int, []previewedEmail, error) {
+	recipients, err := loadRecipients(p.CSVPath)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	bodySrc := p.HTMLBody
+	if bodySrc == "" {
+		htmlBytes, err := os.ReadFile(p.HTMLPath)
+		if err != nil {
+			return 0, nil, err
+		}
+		bodySrc = string(htmlBytes)
+	}
+
+	total := len(recipients)
+	if total > previewLimit {
+		recipients = recipients[:previewLimit]
+	}
+
+	previews := make([]previewedEmail, 0, len(recipients))
+	for _, rec := range recipients {
+		subject, subjErr := renderTemplate("subject", p.Subject, rec)
+		body, bodyErr := renderTemplate("body", bodySrc, rec)
+		switch {
+		case subjErr != nil:
+			previews = append(previews, previewedEmail{email: rec.Email(), err: subjErr})
+		case bodyErr != nil:
+			previews = append(previews, previewedEmail{email: rec.Email(), err: bodyErr})
+		default:
+			previews = append(previews, previewedEmail{email: rec.Email(), subject: subject, body: body})
+		}
+	}
+
+	return total, previews, nil
+}
+
+// missingKeyPattern pulls the offending field name out of the error text
+// text/template produces for Option("missingkey=error").
+var missingKeyPattern = regexp.MustCompile(`no entry for key "([^"]+)"`)
+
+// describeTemplateError reports which merge field a template.ExecError
+// choked on, falling back to the raw error text for any other failure.
+func describeTemplateError(err error) string {
+	var execErr template.ExecError
+	if errors.As(err, &execErr) {
+		if m := missingKeyPattern.FindStringSubmatch(execErr.Err.Error()); m != nil {
+			return fmt.Sprintf("missing merge field %q", m[1])
+		}
+	}
+	return err.Error()
+}
+
+func (s *previewScreen) View() string {
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("Preview"))
+	sb.WriteString("\n\n")
+
+	if s.err != nil {
+		sb.WriteString(statusMessageStyle(fmt.Sprintf("Couldn't load preview: %v", s.err)))
+		return sb.String()
+	}
+
+	if len(s.previews) == 0 {
+		sb.WriteString(itemStyle.Render("No recipients to preview."))
+		return sb.String()
+	}
+
+	mode := "raw HTML"
+	if s.rendered {
+		mode = "rendered"
+	}
+	p := s.previews[s.index]
+	sb.WriteString(itemStyle.Render(fmt.Sprintf("Email %d of %d (first %d of %d recipients, %s)",
+		s.index+1, len(s.previews), len(s.previews), s.total, mode)))
+	sb.WriteString("\n")
+
+	if p.err != nil {
+		sb.WriteString(statusMessageStyle(fmt.Sprintf("Template error for %s: %s", p.email, describeTemplateError(p.err))))
+		return sb.String()
+	}
+
+	sb.WriteString(itemStyle.Render(fmt.Sprintf("To: %s", p.email)))
+	sb.WriteString("\n")
+	sb.WriteString(itemStyle.Render(fmt.Sprintf("Subject: %s", p.subject)))
+	sb.WriteString("\n\n")
+	sb.WriteString(s.viewport.View())
+	return sb.String()
+}
+
+func (s *previewScreen) Help() []key.Binding {
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("tab", "shift+tab"), key.WithHelp("tab/shift+tab", "switch recipient")),
+		key.NewBinding(key.WithKeys("ctrl+r"), key.WithHelp("ctrl+r", "toggle raw/rendered")),
+		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "send")),
+		quitKey,
+	}
+}
+
+// CapturesKey reports false: the preview has no free-text entry, so "q" and
+// ctrl+c should always fall through to the global quit shortcut.
+func (s *previewScreen) CapturesKey(tea.KeyMsg) bool { return false }
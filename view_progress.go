@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// progressScreen drives and renders the live send campaign. It starts the
+// campaign's worker pool as soon as it receives a confirmationPayload and
+// re-renders on every sendProgressMsg the pool emits.
+type progressScreen struct {
+	progress progress.Model
+
+	csvFilePath  string
+	htmlFilePath string
+	subject      string
+
+	campaign        *campaign
+	sent            int
+	failed          int
+	total           int
+	deliveryLogPath string
+	err             error
+
+	tag int
+}
+
+func newProgressScreen() *progressScreen {
+	return &progressScreen{progress: progress.New(progress.WithDefaultGradient())}
+}
+
+func (s *progressScreen) Init() tea.Cmd { return nil }
+
+func (s *progressScreen) Update(msg tea.Msg) (View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case transitionMsg:
+		if payload, ok := msg.Payload.(confirmationPayload); ok {
+			return s.startCampaign(payload)
+		}
+		return s, nil
+
+	case tea.WindowSizeMsg:
+		s.progress.Width = msg.Width - padding*2 - 4
+		if s.progress.Width > maxWidth {
+			s.progress.Width = maxWidth
+		}
+		return s, nil
+
+	case sendProgressMsg:
+		s.sent, s.failed, s.total = msg.Sent, msg.Failed, msg.Total
+		cmd := s.progress.SetPercent(float64(msg.Sent+msg.Failed) / float64(msg.Total))
+		return s, tea.Batch(waitForCampaignEvent(s.campaign), cmd)
+
+	case sendResultMsg:
+		return s, waitForCampaignEvent(s.campaign)
+
+	case sendDoneMsg:
+		s.deliveryLogPath = msg.LogPath
+		return s, nil
+
+	case progress.FrameMsg:
+		progressModel, cmd := s.progress.Update(msg)
+		s.progress = progressModel.(progress.Model)
+		return s, cmd
+
+	case tea.KeyMsg:
+		// Any key while sending debounces a 5-second auto-quit instead of
+		// exiting immediately, so a stray keypress can't abort a send.
+		s.tag++
+		tag := s.tag
+		return s, tea.Tick(debounceDuration, func(_ time.Time) tea.Msg {
+			return exitMsg(tag)
+		})
+
+	case exitMsg:
+		if int(msg) == s.tag {
+			return s, tea.Quit
+		}
+	}
+
+	return s, nil
+}
+
+// startCampaign loads the CSV recipients and HTML template chosen earlier in
+// the wizard, launches the send pipeline in the background, and returns the
+// command that pulls its first progress event into the Bubble Tea loop.
+func (s *progressScreen) startCampaign(p confirmationPayload) (View, tea.Cmd) {
+	s.csvFilePath, s.htmlFilePath, s.subject = p.CSVPath, p.HTMLPath, p.Subject
+
+	recipients, err := loadRecipients(p.CSVPath)
+	if err != nil {
+		s.err = err
+		return s, nil
+	}
+
+	bodySrc := p.HTMLBody
+	if bodySrc == "" {
+		htmlBytes, err := os.ReadFile(p.HTMLPath)
+		if err != nil {
+			s.err = err
+			return s, nil
+		}
+		bodySrc = string(htmlBytes)
+	}
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		s.err = err
+		return s, nil
+	}
+
+	c := newCampaign(cfg, recipients, p.Subject, bodySrc, p.Attachments)
+	s.campaign = c
+	s.total = len(recipients)
+
+	go c.run()
+
+	return s, waitForCampaignEvent(c)
+}
+
+func (s *progressScreen) View() string {
+	pad := strings.Repeat(" ", padding)
+
+	if s.err != nil {
+		return "\n" + pad + statusMessageStyle(fmt.Sprintf("Send failed: %v", s.err))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\n" + pad + s.progress.View())
+	if s.deliveryLogPath != "" {
+		sb.WriteString("\n\n" + pad + statusMessageStyle(fmt.Sprintf("Done: %d sent, %d failed", s.sent, s.failed)))
+		sb.WriteString("\n" + pad + helpStyle(fmt.Sprintf("Delivery log: %s", s.deliveryLogPath)))
+	} else {
+		sb.WriteString("\n\n" + pad + helpStyle(fmt.Sprintf("Sending emails... (%d/%d, %d failed)", s.sent+s.failed, s.total, s.failed)))
+	}
+	sb.WriteString("\n\n" + pad + helpStyle("Press q and wait for 5 second to quit"))
+	return sb.String()
+}
+
+func (s *progressScreen) Help() []key.Binding {
+	return nil
+}
+
+// CapturesKey reports true: every key while sending must reach Update so it
+// can arm the 5-second auto-quit debounce instead of exiting immediately.
+func (s *progressScreen) CapturesKey(tea.KeyMsg) bool { return true }
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// quitScreen shows a countdown before exiting. Nothing currently transitions
+// here (the wizard quits directly from wherever the user cancels), but it
+// stays registered so a future screen can route through it for a confirmed
+// exit sequence.
+type quitScreen struct {
+	quitTimer time.Time
+}
+
+func (s *quitScreen) Init() tea.Cmd { return nil }
+
+func (s *quitScreen) Update(msg tea.Msg) (View, tea.Cmd) {
+	if _, ok := msg.(tea.KeyMsg); ok {
+		if time.Since(s.quitTimer) >= 5*time.Second {
+			return s, tea.Quit
+		}
+	}
+	return s, nil
+}
+
+func (s *quitScreen) View() string {
+	remaining := 5 - int(time.Since(s.quitTimer).Seconds())
+	return quitTextStyle(fmt.Sprintf("Quitting in %d seconds...", remaining))
+}
+
+func (s *quitScreen) Help() []key.Binding {
+	return nil
+}
+
+// CapturesKey reports false: the countdown has no free-text entry.
+func (s *quitScreen) CapturesKey(tea.KeyMsg) bool { return false }
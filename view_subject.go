@@ -0,0 +1,95 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// subjectInputScreen collects the email subject line, after which it hands
+// the whole wizard state off to confirmationScreen.
+type subjectInputScreen struct {
+	textInput    textinput.Model
+	csvFilePath  string
+	htmlFilePath string
+	htmlBody     string
+}
+
+func newSubjectInputScreen() *subjectInputScreen {
+	ti := textinput.New()
+	ti.Placeholder = "Enter email subject"
+	ti.Focus()
+	return &subjectInputScreen{textInput: ti}
+}
+
+func (s *subjectInputScreen) Init() tea.Cmd { return textinput.Blink }
+
+func (s *subjectInputScreen) Update(msg tea.Msg) (View, tea.Cmd) {
+	if t, ok := msg.(transitionMsg); ok {
+		switch payload := t.Payload.(type) {
+		case fileSelectionPayload:
+			s.csvFilePath = payload.CSVPath
+			s.htmlFilePath = payload.HTMLPath
+			s.htmlBody = ""
+		case markdownComposePayload:
+			s.csvFilePath = payload.CSVPath
+			s.htmlFilePath = ""
+			s.htmlBody = payload.HTMLBody
+		}
+		return s, textinput.Blink
+	}
+
+	if wsMsg, ok := msg.(tea.WindowSizeMsg); ok {
+		h, _ := style.GetFrameSize()
+		width := wsMsg.Width - h
+		if width > 1 {
+			s.textInput.Width = width
+		}
+		return s, nil
+	}
+
+	var cmd tea.Cmd
+	s.textInput, cmd = s.textInput.Update(msg)
+
+	if key, ok := msg.(tea.KeyMsg); ok && key.Type == tea.KeyEnter {
+		subject := s.textInput.Value()
+		csvPath, htmlPath, htmlBody := s.csvFilePath, s.htmlFilePath, s.htmlBody
+		return s, func() tea.Msg {
+			return transitionMsg{
+				To: AttachmentsState,
+				Payload: subjectPayload{
+					CSVPath: csvPath, HTMLPath: htmlPath, HTMLBody: htmlBody, Subject: subject,
+				},
+			}
+		}
+	}
+
+	return s, cmd
+}
+
+func (s *subjectInputScreen) View() string {
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("Email Subject"))
+	sb.WriteString("\n\n")
+	sb.WriteString(itemStyle.Render("Enter the email subject:"))
+	sb.WriteString("\n")
+	sb.WriteString(s.textInput.View())
+	return sb.String()
+}
+
+func (s *subjectInputScreen) Help() []key.Binding {
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "continue")),
+		quitKey,
+	}
+}
+
+// CapturesKey reports true for everything but ctrl+c: the textinput is
+// always focused here, so "q" must reach it as a literal character instead
+// of triggering the global quit shortcut. ctrl+c still quits immediately,
+// since the textinput doesn't bind it to anything itself.
+func (s *subjectInputScreen) CapturesKey(msg tea.KeyMsg) bool {
+	return msg.String() != "ctrl+c"
+}